@@ -0,0 +1,52 @@
+package cipher
+
+// Caesar is the classical shift cipher: every letter is shifted by a fixed
+// number of places in the alphabet.
+type Caesar struct {
+	shift int // 0-25
+}
+
+// NewCaesar creates a Caesar cipher with the given shift, normalized to 0-25.
+func NewCaesar(shift int) *Caesar {
+	return &Caesar{shift: ((shift % 26) + 26) % 26}
+}
+
+// Encrypt normalizes plaintext and shifts every letter forward.
+func (c *Caesar) Encrypt(plaintext string) string {
+	return shiftString(Normalize(plaintext), c.shift)
+}
+
+// Decrypt normalizes ciphertext and shifts every letter back.
+func (c *Caesar) Decrypt(ciphertext string) string {
+	return shiftString(Normalize(ciphertext), -c.shift)
+}
+
+func shiftString(s string, shift int) string {
+	shift = ((shift % 26) + 26) % 26
+	out := make([]byte, len(s))
+	for i := 0; i < len(s); i++ {
+		out[i] = byte('A' + (int(s[i]-'A')+shift)%26)
+	}
+	return string(out)
+}
+
+// CaesarKey is a Caesar cipher's shift.
+type CaesarKey struct {
+	Shift int
+}
+
+// Cipher builds the Caesar cipher configured by this key.
+func (k CaesarKey) Cipher() Cipher {
+	return NewCaesar(k.Shift)
+}
+
+// RandomCaesarKey generates a random shift using crypto/rand.
+func RandomCaesarKey() (CaesarKey, error) {
+	shift, err := randomInt(26)
+	if err != nil {
+		return CaesarKey{}, err
+	}
+	return CaesarKey{Shift: shift}, nil
+}
+
+var _ Key = CaesarKey{}
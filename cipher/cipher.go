@@ -0,0 +1,17 @@
+// Package cipher collects classical ciphers behind a uniform Cipher/Key
+// interface, so they can be composed or benchmarked through one API.
+package cipher
+
+// Cipher is implemented by every cipher in this package, including
+// enigma.Enigma.
+type Cipher interface {
+	Encrypt(plaintext string) string
+	Decrypt(ciphertext string) string
+}
+
+// Key produces a Cipher configured with specific key material. Each cipher
+// provides a RandomXKey constructor that generates key material using
+// crypto/rand.
+type Key interface {
+	Cipher() Cipher
+}
@@ -0,0 +1,134 @@
+package cipher
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	got := Normalize("Hello, World! 123")
+	want := "HELLOWORLD"
+	if got != want {
+		t.Fatalf("Normalize(...) = %q, want %q", got, want)
+	}
+}
+
+func TestCaesarRoundTrip(t *testing.T) {
+	c := NewCaesar(3)
+	const plaintext = "ATTACKATDAWN"
+	ciphertext := c.Encrypt(plaintext)
+	if ciphertext == plaintext {
+		t.Fatal("Caesar ciphertext should differ from plaintext")
+	}
+	if got := c.Decrypt(ciphertext); got != plaintext {
+		t.Fatalf("Decrypt(Encrypt(%q)) = %q, want %q", plaintext, got, plaintext)
+	}
+}
+
+func TestVigenereRoundTrip(t *testing.T) {
+	v := NewVigenere("LEMON")
+	const plaintext = "ATTACKATDAWN"
+	ciphertext := v.Encrypt(plaintext)
+	const wantCiphertext = "LXFOPVEFRNHR"
+	if ciphertext != wantCiphertext {
+		t.Fatalf("Encrypt(%q) = %q, want %q", plaintext, ciphertext, wantCiphertext)
+	}
+	if got := v.Decrypt(ciphertext); got != plaintext {
+		t.Fatalf("Decrypt(Encrypt(%q)) = %q, want %q", plaintext, got, plaintext)
+	}
+}
+
+func TestSubstitutionRoundTrip(t *testing.T) {
+	s, err := NewSubstitution("QWERTYUIOPASDFGHJKLZXCVBNM")
+	if err != nil {
+		t.Fatalf("NewSubstitution: %v", err)
+	}
+	const plaintext = "HELLOWORLD"
+	ciphertext := s.Encrypt(plaintext)
+	if got := s.Decrypt(ciphertext); got != plaintext {
+		t.Fatalf("Decrypt(Encrypt(%q)) = %q, want %q", plaintext, got, plaintext)
+	}
+}
+
+func TestSubstitutionRejectsShortAlphabet(t *testing.T) {
+	if _, err := NewSubstitution("ABC"); err == nil {
+		t.Fatal("NewSubstitution(\"ABC\"): want error, got nil")
+	}
+}
+
+func TestSubstitutionRejectsDuplicateLetter(t *testing.T) {
+	if _, err := NewSubstitution("AABCDEFGHIJKLMNOPQRSTUVWXY"); err == nil {
+		t.Fatal("NewSubstitution with duplicate letter: want error, got nil")
+	}
+}
+
+// TestPlayfairRoundTrip checks a message with no repeated-letter pairs and
+// even length, so Playfair's X-padding never kicks in and the round trip
+// is exact.
+func TestPlayfairRoundTrip(t *testing.T) {
+	p := NewPlayfair("PLAYFAIREXAMPLE")
+	const plaintext = "THEQUICKBROWNFOX"
+	ciphertext := p.Encrypt(plaintext)
+	if got := p.Decrypt(ciphertext); got != plaintext {
+		t.Fatalf("Decrypt(Encrypt(%q)) = %q, want %q", plaintext, got, plaintext)
+	}
+}
+
+func TestPlayfairKnownReference(t *testing.T) {
+	p := NewPlayfair("PLAYFAIREXAMPLE")
+	const plaintext = "HIDETHEGOLDINTHETREESTUMP"
+	const want = "BMODZBXDNABEKUDMUIXMMOUVIF"
+	if got := p.Encrypt(plaintext); got != want {
+		t.Fatalf("Encrypt(%q) = %q, want %q", plaintext, got, want)
+	}
+}
+
+func TestRandomKeysProduceWorkingCiphers(t *testing.T) {
+	const plaintext = "THEQUICKBROWNFOXJUMPSOVERTHELAZYDOG"
+
+	keys := []Key{}
+	if k, err := RandomCaesarKey(); err != nil {
+		t.Fatalf("RandomCaesarKey: %v", err)
+	} else {
+		keys = append(keys, k)
+	}
+	if k, err := RandomVigenereKey(8); err != nil {
+		t.Fatalf("RandomVigenereKey: %v", err)
+	} else {
+		keys = append(keys, k)
+	}
+	if k, err := RandomPlayfairKey(8); err != nil {
+		t.Fatalf("RandomPlayfairKey: %v", err)
+	} else {
+		keys = append(keys, k)
+	}
+	if k, err := RandomSubstitutionKey(); err != nil {
+		t.Fatalf("RandomSubstitutionKey: %v", err)
+	} else {
+		keys = append(keys, k)
+	}
+	if k, err := RandomEnigmaKey(); err != nil {
+		t.Fatalf("RandomEnigmaKey: %v", err)
+	} else {
+		keys = append(keys, k)
+	}
+
+	for _, k := range keys {
+		c := k.Cipher()
+		ciphertext := c.Encrypt(plaintext)
+		if ciphertext == "" {
+			t.Errorf("%T: Encrypt returned empty string", k)
+		}
+	}
+}
+
+func TestRandomEnigmaKeyRotorsAreDistinct(t *testing.T) {
+	k, err := RandomEnigmaKey()
+	if err != nil {
+		t.Fatalf("RandomEnigmaKey: %v", err)
+	}
+	seen := make(map[string]bool, 3)
+	for _, name := range k.Rotors {
+		if seen[name] {
+			t.Fatalf("RandomEnigmaKey produced duplicate rotor %q in %v", name, k.Rotors)
+		}
+		seen[name] = true
+	}
+}
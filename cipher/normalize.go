@@ -0,0 +1,20 @@
+package cipher
+
+import "strings"
+
+// Normalize uppercases s and strips every character that is not a letter
+// A-Z. It is the common preprocessing step shared by every cipher in this
+// package.
+func Normalize(s string) string {
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for _, r := range s {
+		if r >= 'a' && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		if r >= 'A' && r <= 'Z' {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,53 @@
+package cipher
+
+import (
+	"crypto/rand"
+	"math/big"
+)
+
+// randomInt returns a uniform random integer in [0, n) using crypto/rand.
+func randomInt(n int) (int, error) {
+	v, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+	return int(v.Int64()), nil
+}
+
+// shuffle performs an in-place Fisher-Yates shuffle over n elements using
+// crypto/rand, calling swap(i, j) for each transposition.
+func shuffle(n int, swap func(i, j int)) error {
+	for i := n - 1; i > 0; i-- {
+		j, err := randomInt(i + 1)
+		if err != nil {
+			return err
+		}
+		swap(i, j)
+	}
+	return nil
+}
+
+// randomLetters returns n random uppercase letters.
+func randomLetters(n int) (string, error) {
+	out := make([]byte, n)
+	for i := range out {
+		v, err := randomInt(26)
+		if err != nil {
+			return "", err
+		}
+		out[i] = byte('A' + v)
+	}
+	return string(out), nil
+}
+
+// randomAlphabetPermutation returns a random permutation of A-Z, drawn via
+// a Fisher-Yates shuffle.
+func randomAlphabetPermutation() (string, error) {
+	letters := []byte("ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+	if err := shuffle(len(letters), func(i, j int) {
+		letters[i], letters[j] = letters[j], letters[i]
+	}); err != nil {
+		return "", err
+	}
+	return string(letters), nil
+}
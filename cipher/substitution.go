@@ -0,0 +1,83 @@
+package cipher
+
+import "fmt"
+
+// Substitution is a general monoalphabetic substitution cipher: every
+// plaintext letter maps to a fixed, distinct ciphertext letter.
+type Substitution struct {
+	forward  [26]byte
+	backward [26]byte
+}
+
+// NewSubstitution creates a Substitution cipher from a 26-letter alphabet,
+// where alphabet[i] is the ciphertext letter for plaintext letter 'A'+i.
+// It returns an error if alphabet does not normalize to exactly 26 distinct
+// letters.
+func NewSubstitution(alphabet string) (*Substitution, error) {
+	alphabet = Normalize(alphabet)
+	if len(alphabet) != 26 {
+		return nil, fmt.Errorf("cipher: substitution alphabet must have 26 letters, got %d", len(alphabet))
+	}
+
+	var s Substitution
+	var seen [26]bool
+	for i := 0; i < 26; i++ {
+		c := alphabet[i]
+		idx := c - 'A'
+		if seen[idx] {
+			return nil, fmt.Errorf("cipher: substitution alphabet has duplicate letter %q", c)
+		}
+		seen[idx] = true
+		s.forward[i] = c
+		s.backward[idx] = byte('A' + i)
+	}
+	return &s, nil
+}
+
+// Encrypt normalizes plaintext and substitutes each letter.
+func (s *Substitution) Encrypt(plaintext string) string {
+	in := Normalize(plaintext)
+	out := make([]byte, len(in))
+	for i := 0; i < len(in); i++ {
+		out[i] = s.forward[in[i]-'A']
+	}
+	return string(out)
+}
+
+// Decrypt normalizes ciphertext and reverses the substitution.
+func (s *Substitution) Decrypt(ciphertext string) string {
+	in := Normalize(ciphertext)
+	out := make([]byte, len(in))
+	for i := 0; i < len(in); i++ {
+		out[i] = s.backward[in[i]-'A']
+	}
+	return string(out)
+}
+
+// SubstitutionKey is a substitution cipher's alphabet.
+type SubstitutionKey struct {
+	Alphabet string
+}
+
+// Cipher builds the Substitution cipher configured by this key. It panics
+// if Alphabet is not a valid 26-letter permutation; RandomSubstitutionKey
+// always produces one.
+func (k SubstitutionKey) Cipher() Cipher {
+	s, err := NewSubstitution(k.Alphabet)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// RandomSubstitutionKey generates a random 26-letter substitution alphabet
+// via a Fisher-Yates shuffle over crypto/rand.
+func RandomSubstitutionKey() (SubstitutionKey, error) {
+	alphabet, err := randomAlphabetPermutation()
+	if err != nil {
+		return SubstitutionKey{}, err
+	}
+	return SubstitutionKey{Alphabet: alphabet}, nil
+}
+
+var _ Key = SubstitutionKey{}
@@ -0,0 +1,66 @@
+package cipher
+
+// Vigenere is the classical polyalphabetic cipher: each letter is shifted
+// by the corresponding letter of a repeating keyword.
+type Vigenere struct {
+	keyword string
+}
+
+// NewVigenere creates a Vigenere cipher with the given keyword. The keyword
+// is normalized (uppercased, non-letters dropped); an empty keyword leaves
+// the text unchanged.
+func NewVigenere(keyword string) *Vigenere {
+	return &Vigenere{keyword: Normalize(keyword)}
+}
+
+// Encrypt normalizes plaintext and shifts each letter forward by the
+// corresponding keyword letter.
+func (v *Vigenere) Encrypt(plaintext string) string {
+	s := Normalize(plaintext)
+	if len(v.keyword) == 0 {
+		return s
+	}
+	out := make([]byte, len(s))
+	for i := 0; i < len(s); i++ {
+		shift := int(v.keyword[i%len(v.keyword)] - 'A')
+		out[i] = byte('A' + (int(s[i]-'A')+shift)%26)
+	}
+	return string(out)
+}
+
+// Decrypt normalizes ciphertext and shifts each letter back by the
+// corresponding keyword letter.
+func (v *Vigenere) Decrypt(ciphertext string) string {
+	s := Normalize(ciphertext)
+	if len(v.keyword) == 0 {
+		return s
+	}
+	out := make([]byte, len(s))
+	for i := 0; i < len(s); i++ {
+		shift := int(v.keyword[i%len(v.keyword)] - 'A')
+		out[i] = byte('A' + ((int(s[i]-'A')-shift)%26+26)%26)
+	}
+	return string(out)
+}
+
+// VigenereKey is a Vigenere cipher's keyword.
+type VigenereKey struct {
+	Keyword string
+}
+
+// Cipher builds the Vigenere cipher configured by this key.
+func (k VigenereKey) Cipher() Cipher {
+	return NewVigenere(k.Keyword)
+}
+
+// RandomVigenereKey generates a random keyword of the given length using
+// crypto/rand.
+func RandomVigenereKey(length int) (VigenereKey, error) {
+	keyword, err := randomLetters(length)
+	if err != nil {
+		return VigenereKey{}, err
+	}
+	return VigenereKey{Keyword: keyword}, nil
+}
+
+var _ Key = VigenereKey{}
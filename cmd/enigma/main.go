@@ -0,0 +1,148 @@
+// Command enigma encrypts or decrypts stdin through a simulated Enigma
+// machine and writes the result to stdout, grouped in 5-letter blocks.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/Mihir22c/Enigma/enigma"
+)
+
+func main() {
+	if err := run(os.Stdin, os.Stdout, os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "enigma:", err)
+		os.Exit(1)
+	}
+}
+
+func run(stdin io.Reader, stdout io.Writer, args []string) error {
+	fs := flag.NewFlagSet("enigma", flag.ContinueOnError)
+	rotors := fs.String("rotors", "I,II,III", "comma-separated rotor choice, left to right (e.g. I,II,III or Beta,II,IV,V for an M4)")
+	reflector := fs.String("reflector", "B", "reflector name (A, B, C, BThin or CThin)")
+	rings := fs.String("rings", "", "ring settings, one letter per rotor, left to right (default all A)")
+	positions := fs.String("positions", "", "starting positions, one letter per rotor, left to right (default all A)")
+	plugboard := fs.String("plugboard", "", `plugboard pairs, e.g. "AZ BY CX MU LV"`)
+	groupSize := fs.Int("group", 5, "ciphertext group size")
+	lineWidth := fs.Int("width", 60, "characters per output line (0 for a single line)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *groupSize <= 0 {
+		return fmt.Errorf("-group must be positive, got %d", *groupSize)
+	}
+
+	rotorChoice := strings.Split(*rotors, ",")
+
+	ringLetters := *rings
+	if ringLetters == "" {
+		ringLetters = strings.Repeat("A", len(rotorChoice))
+	}
+	positionLetters := *positions
+	if positionLetters == "" {
+		positionLetters = strings.Repeat("A", len(rotorChoice))
+	}
+
+	ringOffsets, err := letterOffsets(ringLetters)
+	if err != nil {
+		return fmt.Errorf("rings: %w", err)
+	}
+	positionOffsets, err := letterOffsets(positionLetters)
+	if err != nil {
+		return fmt.Errorf("positions: %w", err)
+	}
+	if len(ringOffsets) != len(rotorChoice) {
+		return fmt.Errorf("-rings must have one letter per rotor: got %d letters for %d rotors", len(ringOffsets), len(rotorChoice))
+	}
+	if len(positionOffsets) != len(rotorChoice) {
+		return fmt.Errorf("-positions must have one letter per rotor: got %d letters for %d rotors", len(positionOffsets), len(rotorChoice))
+	}
+
+	e, err := buildEnigma(rotorChoice, *reflector, ringOffsets, positionOffsets, *plugboard)
+	if err != nil {
+		return err
+	}
+
+	plaintext, err := io.ReadAll(stdin)
+	if err != nil {
+		return fmt.Errorf("reading input: %w", err)
+	}
+
+	// Encrypt normalizes the input itself (uppercasing and dropping
+	// anything outside A-Z).
+	ciphertext := e.Encrypt(string(plaintext))
+
+	w := bufio.NewWriter(stdout)
+	fmt.Fprintln(w, groupOutput(ciphertext, *groupSize, *lineWidth))
+	return w.Flush()
+}
+
+// buildEnigma wires up an M3 or M4 depending on how many rotors were
+// chosen.
+func buildEnigma(rotorChoice []string, reflector string, rings, positions []int, plugboard string) (*enigma.Enigma, error) {
+	switch len(rotorChoice) {
+	case 3:
+		return enigma.NewM3(
+			[3]string{rotorChoice[0], rotorChoice[1], rotorChoice[2]},
+			reflector,
+			[3]int{rings[0], rings[1], rings[2]},
+			[3]int{positions[0], positions[1], positions[2]},
+			plugboard,
+		)
+	case 4:
+		return enigma.NewM4(
+			[4]string{rotorChoice[0], rotorChoice[1], rotorChoice[2], rotorChoice[3]},
+			reflector,
+			[4]int{rings[0], rings[1], rings[2], rings[3]},
+			[4]int{positions[0], positions[1], positions[2], positions[3]},
+			plugboard,
+		)
+	default:
+		return nil, fmt.Errorf("-rotors must name 3 rotors (M3) or 4 rotors (M4), got %d", len(rotorChoice))
+	}
+}
+
+// letterOffsets converts a string of letters into 0-25 offsets.
+func letterOffsets(letters string) ([]int, error) {
+	offsets := make([]int, len(letters))
+	for i := 0; i < len(letters); i++ {
+		c := letters[i]
+		if c < 'A' || c > 'Z' {
+			return nil, fmt.Errorf("invalid letter %q", c)
+		}
+		offsets[i] = int(c - 'A')
+	}
+	return offsets, nil
+}
+
+// groupOutput splits s into groups of groupSize characters separated by
+// spaces, wrapping onto a new line before exceeding lineWidth characters
+// per line. lineWidth <= 0 disables wrapping.
+func groupOutput(s string, groupSize, lineWidth int) string {
+	var sb strings.Builder
+	lineLen := 0
+	for i := 0; i < len(s); i += groupSize {
+		end := i + groupSize
+		if end > len(s) {
+			end = len(s)
+		}
+		group := s[i:end]
+
+		if lineLen > 0 {
+			if lineWidth > 0 && lineLen+1+len(group) > lineWidth {
+				sb.WriteByte('\n')
+				lineLen = 0
+			} else {
+				sb.WriteByte(' ')
+				lineLen++
+			}
+		}
+		sb.WriteString(group)
+		lineLen += len(group)
+	}
+	return sb.String()
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGroupOutput(t *testing.T) {
+	tests := []struct {
+		name      string
+		s         string
+		groupSize int
+		lineWidth int
+		want      string
+	}{
+		{"empty", "", 5, 60, ""},
+		{"exact groups", "ABCDEFGHIJ", 5, 60, "ABCDE FGHIJ"},
+		{"partial last group", "ABCDEFGH", 5, 60, "ABCDE FGH"},
+		{"no wrap limit", strings.Repeat("A", 20), 5, 0, "AAAAA AAAAA AAAAA AAAAA"},
+		{"wraps at width", "ABCDEFGHIJKLMNO", 5, 11, "ABCDE FGHIJ\nKLMNO"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := groupOutput(tt.s, tt.groupSize, tt.lineWidth)
+			if got != tt.want {
+				t.Errorf("groupOutput(%q, %d, %d) = %q, want %q", tt.s, tt.groupSize, tt.lineWidth, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunEncryptsStdin(t *testing.T) {
+	var out strings.Builder
+	in := strings.NewReader("Hello, Enigma!")
+
+	if err := run(in, &out, []string{"-rotors", "I,II,III", "-reflector", "B"}); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+
+	got := strings.ReplaceAll(strings.TrimSpace(out.String()), " ", "")
+	if len(got) != len("HELLOENIGMA") {
+		t.Fatalf("output length = %d, want %d (non-letters should be dropped)", len(got), len("HELLOENIGMA"))
+	}
+	for _, c := range got {
+		if c < 'A' || c > 'Z' {
+			t.Fatalf("output contains non-uppercase-letter %q", c)
+		}
+	}
+}
+
+func TestRunRejectsNonPositiveGroup(t *testing.T) {
+	var out strings.Builder
+	in := strings.NewReader("HELLO")
+
+	if err := run(in, &out, []string{"-group", "0"}); err == nil {
+		t.Fatal("run with -group 0: want error, got nil")
+	}
+}
+
+func TestRunRejectsMismatchedRingsLength(t *testing.T) {
+	var out strings.Builder
+	in := strings.NewReader("HELLO")
+
+	if err := run(in, &out, []string{"-rotors", "I,II,III", "-rings", "AB"}); err == nil {
+		t.Fatal("run with 3 rotors and 2 -rings letters: want error, got nil")
+	}
+}
+
+func TestRunRejectsMismatchedPositionsLength(t *testing.T) {
+	var out strings.Builder
+	in := strings.NewReader("HELLO")
+
+	if err := run(in, &out, []string{"-rotors", "I,II,III", "-positions", "ABCD"}); err == nil {
+		t.Fatal("run with 3 rotors and 4 -positions letters: want error, got nil")
+	}
+}
+
+func TestRunRejectsBadRotorCount(t *testing.T) {
+	var out strings.Builder
+	in := strings.NewReader("HELLO")
+
+	if err := run(in, &out, []string{"-rotors", "I,II"}); err == nil {
+		t.Fatal("run with 2 rotors: want error, got nil")
+	}
+}
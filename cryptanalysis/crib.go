@@ -0,0 +1,163 @@
+// Package cryptanalysis implements a simplified, teaching-oriented version
+// of the crib-based attacks historically used against the Enigma: given a
+// ciphertext and a guessed plaintext fragment ("crib"), it searches for
+// machine settings under which that crib could have produced the
+// ciphertext.
+package cryptanalysis
+
+import (
+	"github.com/Mihir22c/Enigma/enigma"
+)
+
+// RotorCatalog lists the rotor names (as known to the enigma package's
+// catalog, e.g. "I".."VIII") that Crib is allowed to try in the rotor
+// order it searches.
+type RotorCatalog []string
+
+// StandardCatalog is the five Wehrmacht service rotors, the usual search
+// space for attacking a 3-rotor M3.
+var StandardCatalog = RotorCatalog{"I", "II", "III", "IV", "V"}
+
+// Candidate is an M3 Enigma configuration consistent with a crib: a rotor
+// order, starting positions (left to right, matching enigma.NewM3's
+// convention; ring settings are held at 0), and the partial plugboard
+// wiring implied by walking the crib against the ciphertext.
+type Candidate struct {
+	RotorOrder []string
+	Positions  [3]int
+	Plugboard  map[rune]rune
+}
+
+// reflector is the reflector Crib assumes, fixed at the standard M3
+// reflector B; v1 does not search the reflector dimension.
+const reflector = "B"
+
+// Crib searches for M3 rotor orders and starting positions under which
+// crib, assumed to be the plaintext for the first len(crib) letters of
+// ciphertext, is consistent with the ciphertext. It assumes reflector B,
+// the standard M3 reflector.
+//
+// For each candidate setting, Crib walks the crib/ciphertext pair letter
+// by letter through a plugboard-free machine and builds up the partial
+// plugboard implied by the mismatches between the machine's raw output
+// and the ciphertext, rejecting the setting outright the moment a letter
+// would have to encrypt to itself (impossible on a real Enigma, thanks to
+// the reflector) or a letter is forced to pair with two different
+// plugboard partners. This is a single-sided simplification: it assumes
+// the crib's plaintext letters enter the machine unplugged, so it can
+// miss genuine solutions where the crib happens to use a plugged letter.
+// That, the lack of any crib-offset search, and holding ring settings at
+// 0 all keep this a teaching demonstration of the technique rather than a
+// real Bombe, which resolved such assumptions with a menu of interlocking
+// loops across several crib placements.
+//
+// Time complexity is O(P(n,3) * 26^3 * len(crib)), where P(n,3) is the
+// number of 3-rotor permutations drawn from catalog (60 for the 5-rotor
+// StandardCatalog) and 26^3 is the exhaustive search over starting
+// positions: every surviving setting costs one machine build and one
+// step per crib letter, but the search itself visits all of them
+// regardless of how quickly a wrong setting could be ruled out.
+func Crib(ciphertext, crib string, catalog RotorCatalog) []Candidate {
+	ciphertext = normalize(ciphertext)
+	crib = normalize(crib)
+	if len(crib) == 0 || len(crib) > len(ciphertext) {
+		return nil
+	}
+	target := ciphertext[:len(crib)]
+
+	var candidates []Candidate
+	for _, order := range permutations3(catalog) {
+		for p0 := 0; p0 < 26; p0++ {
+			for p1 := 0; p1 < 26; p1++ {
+				for p2 := 0; p2 < 26; p2++ {
+					positions := [3]int{p0, p1, p2}
+					plugboard, ok := tryCandidate(order, positions, reflector, crib, target)
+					if !ok {
+						continue
+					}
+					candidates = append(candidates, Candidate{
+						RotorOrder: append([]string(nil), order[:]...),
+						Positions:  positions,
+						Plugboard:  plugboard,
+					})
+				}
+			}
+		}
+	}
+	return candidates
+}
+
+// tryCandidate builds an M3 at the given rotor order, positions and
+// reflector, with no plugboard, and walks crib against target one letter
+// at a time. It returns the plugboard implied by any mismatches and
+// whether the setting survived without contradiction.
+func tryCandidate(order [3]string, positions [3]int, reflector, crib, target string) (map[rune]rune, bool) {
+	e, err := enigma.NewM3(order, reflector, [3]int{0, 0, 0}, positions, "")
+	if err != nil {
+		return nil, false
+	}
+
+	plugboard := make(map[rune]rune)
+	for i := 0; i < len(crib); i++ {
+		p, c := rune(crib[i]), rune(target[i])
+		if p == c {
+			return nil, false
+		}
+
+		q := rune(e.Encrypt(string(p))[0])
+		if q == c {
+			continue
+		}
+		if partner, ok := plugboard[q]; ok {
+			if partner != c {
+				return nil, false
+			}
+			continue
+		}
+		if partner, ok := plugboard[c]; ok {
+			if partner != q {
+				return nil, false
+			}
+			continue
+		}
+		plugboard[q] = c
+		plugboard[c] = q
+	}
+	return plugboard, true
+}
+
+// permutations3 returns every ordered way to pick 3 distinct rotor names
+// from names.
+func permutations3(names RotorCatalog) [][3]string {
+	var out [][3]string
+	for i := range names {
+		for j := range names {
+			if j == i {
+				continue
+			}
+			for k := range names {
+				if k == i || k == j {
+					continue
+				}
+				out = append(out, [3]string{names[i], names[j], names[k]})
+			}
+		}
+	}
+	return out
+}
+
+// normalize uppercases s and drops anything outside A-Z, matching the
+// enigma package's own normalization.
+func normalize(s string) string {
+	out := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		if c >= 'A' && c <= 'Z' {
+			out = append(out, c)
+		}
+	}
+	return string(out)
+}
@@ -0,0 +1,60 @@
+package cryptanalysis
+
+import (
+	"testing"
+
+	"github.com/Mihir22c/Enigma/enigma"
+)
+
+// smallCatalog keeps the search space small enough for a unit test: 3
+// rotors gives 6 rotor-order permutations instead of StandardCatalog's 60.
+var smallCatalog = RotorCatalog{"I", "II", "III"}
+
+func TestCribRecoversKnownSetting(t *testing.T) {
+	wantOrder := [3]string{"III", "II", "I"}
+	wantPositions := [3]int{5, 12, 3}
+
+	e, err := enigma.NewM3(wantOrder, reflector, [3]int{0, 0, 0}, wantPositions, "")
+	if err != nil {
+		t.Fatalf("NewM3: %v", err)
+	}
+	const plaintext = "ATTACKATDAWN"
+	ciphertext := e.Encrypt(plaintext)
+
+	candidates := Crib(ciphertext, plaintext, smallCatalog)
+	if len(candidates) == 0 {
+		t.Fatal("Crib found no candidates, want at least the planted setting")
+	}
+
+	found := false
+	for _, c := range candidates {
+		if c.RotorOrder[0] == wantOrder[0] && c.RotorOrder[1] == wantOrder[1] && c.RotorOrder[2] == wantOrder[2] &&
+			c.Positions == wantPositions {
+			found = true
+			if len(c.Plugboard) != 0 {
+				t.Errorf("planted setting used no plugboard, but Crib inferred %v", c.Plugboard)
+			}
+			break
+		}
+	}
+	if !found {
+		t.Fatalf("Crib did not recover the planted setting (rotors %v, positions %v) among %d candidates",
+			wantOrder, wantPositions, len(candidates))
+	}
+}
+
+func TestCribRejectsSelfEncryption(t *testing.T) {
+	// A crib letter identical to its aligned ciphertext letter can never
+	// have come from a real Enigma (the reflector guarantees a letter
+	// never maps to itself), so every candidate must be rejected.
+	candidates := Crib("AAAAA", "AAAAA", smallCatalog)
+	if len(candidates) != 0 {
+		t.Fatalf("Crib(%q, %q) = %d candidates, want 0", "AAAAA", "AAAAA", len(candidates))
+	}
+}
+
+func TestCribRejectsCribLongerThanCiphertext(t *testing.T) {
+	if got := Crib("AB", "ABCDE", smallCatalog); got != nil {
+		t.Fatalf("Crib with crib longer than ciphertext = %v, want nil", got)
+	}
+}
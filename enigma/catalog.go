@@ -0,0 +1,156 @@
+package enigma
+
+import "fmt"
+
+// RotorSpec describes a historical rotor's wiring and notch positions. It
+// is a template: call New to get a fresh *Rotor with its own ring setting
+// and position.
+type RotorSpec struct {
+	wiring  string
+	notches []int
+}
+
+// New returns a fresh *Rotor using this spec's wiring and notches, with the
+// given ring setting and starting position.
+func (s RotorSpec) New(ring, position int) *Rotor {
+	return NewRotor(s.wiring, ring, position, s.notches...)
+}
+
+// rotorCatalog holds the historical Wehrmacht/Kriegsmarine rotor wirings.
+// I-V are the original service rotors; VI-VIII are the naval rotors with
+// two notches each; Beta and Gamma are the thin rotors used in the fourth
+// (non-stepping) slot of the M4.
+type rotorCatalog struct {
+	I, II, III, IV, V, VI, VII, VIII RotorSpec
+	Beta, Gamma                      RotorSpec
+}
+
+// Rotors is the catalog of historical rotor wirings, indexed by name.
+var Rotors = rotorCatalog{
+	I:     RotorSpec{wiring: "EKMFLGDQVZNTOWYHXUSPAIBRCJ", notches: []int{16}},     // <Q
+	II:    RotorSpec{wiring: "AJDKSIRUXBLHWTMCQGZNPYFVOE", notches: []int{4}},      // <E
+	III:   RotorSpec{wiring: "BDFHJLCPRTXVZNYEIWGAKMUSQO", notches: []int{21}},     // <V
+	IV:    RotorSpec{wiring: "ESOVPZJAYQUIRHXLNFTGKDCMWB", notches: []int{9}},      // <J
+	V:     RotorSpec{wiring: "VZBRGITYUPSDNHLXAWMJQOFECK", notches: []int{25}},     // <Z
+	VI:    RotorSpec{wiring: "JPGVOUMFYQBENHZRDKASXLICTW", notches: []int{25, 12}}, // <ZM
+	VII:   RotorSpec{wiring: "NZJHGRCXMYSWBOUFAIVLPEKQDT", notches: []int{25, 12}}, // <ZM
+	VIII:  RotorSpec{wiring: "FKQHTLXOCBJSPDZRAMEWNIUYGV", notches: []int{25, 12}}, // <ZM
+	Beta:  RotorSpec{wiring: "LEYJVCNIXWPBQMDRTAKZGFUHOS"},                         // thin, no notch
+	Gamma: RotorSpec{wiring: "FSOKANUERHMBTIYCWLQPZXVGJD"},                         // thin, no notch
+}
+
+var rotorByName = map[string]RotorSpec{
+	"I": Rotors.I, "II": Rotors.II, "III": Rotors.III, "IV": Rotors.IV,
+	"V": Rotors.V, "VI": Rotors.VI, "VII": Rotors.VII, "VIII": Rotors.VIII,
+	"Beta": Rotors.Beta, "Gamma": Rotors.Gamma,
+}
+
+// reflectorCatalog holds the historical reflector wirings. A, B and C are
+// the M3 reflectors; BThin and CThin are the thin reflectors required
+// alongside a Beta/Gamma rotor in the M4.
+type reflectorCatalog struct {
+	A, B, C, BThin, CThin string
+}
+
+// Reflectors is the catalog of historical reflector wirings, indexed by
+// name.
+var Reflectors = reflectorCatalog{
+	A:     "EJMZALYXVBWFCRQUONTSPIKHGD",
+	B:     "YRUHQSLDPXNGOKMIEBFZCWVJAT",
+	C:     "FVPJIAOYEDRZXWGCTKUQSBNMHL",
+	BThin: "ENKQAUYWJICOPBLMDXZVFTHRGS",
+	CThin: "RDOBJNTKVEHMLFCWZAXGYIPSUQ",
+}
+
+var reflectorByName = map[string]string{
+	"A": Reflectors.A, "B": Reflectors.B, "C": Reflectors.C,
+	"BThin": Reflectors.BThin, "CThin": Reflectors.CThin,
+}
+
+func lookupRotor(name string) (RotorSpec, error) {
+	spec, ok := rotorByName[name]
+	if !ok {
+		return RotorSpec{}, fmt.Errorf("enigma: unknown rotor %q", name)
+	}
+	return spec, nil
+}
+
+func lookupReflector(name string) (string, error) {
+	wiring, ok := reflectorByName[name]
+	if !ok {
+		return "", fmt.Errorf("enigma: unknown reflector %q", name)
+	}
+	return wiring, nil
+}
+
+// isThin reports whether the named rotor is one of the non-stepping thin
+// rotors (Beta or Gamma) used in the M4's fourth slot.
+func isThin(name string) bool {
+	return name == "Beta" || name == "Gamma"
+}
+
+// NewM3 builds a standard 3-rotor Enigma. rotorChoice, rings and positions
+// are given left to right as the rotors would be inserted into the
+// machine, e.g. rotorChoice[0] is the leftmost rotor. plugboard is parsed
+// with ParsePlugboard. It returns an error if any rotor or reflector
+// name is not in the catalog.
+func NewM3(rotorChoice [3]string, reflector string, rings, positions [3]int, plugboard string) (*Enigma, error) {
+	refWiring, err := lookupReflector(reflector)
+	if err != nil {
+		return nil, err
+	}
+
+	rotors := make([]*Rotor, 3)
+	for i, name := range rotorChoice {
+		spec, err := lookupRotor(name)
+		if err != nil {
+			return nil, err
+		}
+		// rotorChoice is left to right; the internal slice is fastest
+		// first (right to left).
+		rotors[2-i] = spec.New(rings[i], positions[i])
+	}
+
+	swaps, err := ParsePlugboard(plugboard)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEnigma(rotors, NewReflector(refWiring), NewPlugboard(swaps)), nil
+}
+
+// NewM4 builds a 4-rotor Kriegsmarine Enigma. As with NewM3, rotorChoice,
+// rings and positions are given left to right; rotorChoice[0], the
+// leftmost rotor, must be a thin rotor (Beta or Gamma) and does not step.
+// thinReflector must be one of the thin reflectors (BThin or CThin). It
+// returns an error if any rotor or reflector name is not in the catalog,
+// or if the leftmost rotor is not a thin rotor.
+func NewM4(rotorChoice [4]string, thinReflector string, rings, positions [4]int, plugboard string) (*Enigma, error) {
+	if !isThin(rotorChoice[0]) {
+		return nil, fmt.Errorf("enigma: M4 leftmost rotor must be Beta or Gamma, got %q", rotorChoice[0])
+	}
+
+	refWiring, err := lookupReflector(thinReflector)
+	if err != nil {
+		return nil, err
+	}
+	if thinReflector != "BThin" && thinReflector != "CThin" {
+		return nil, fmt.Errorf("enigma: M4 requires a thin reflector (BThin or CThin), got %q", thinReflector)
+	}
+
+	rotors := make([]*Rotor, 4)
+	for i, name := range rotorChoice {
+		spec, err := lookupRotor(name)
+		if err != nil {
+			return nil, err
+		}
+		rotors[3-i] = spec.New(rings[i], positions[i])
+	}
+
+	swaps, err := ParsePlugboard(plugboard)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEnigma(rotors, NewReflector(refWiring), NewPlugboard(swaps)), nil
+}
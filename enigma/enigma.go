@@ -0,0 +1,111 @@
+package enigma
+
+import "strings"
+
+// Enigma represents the entire Enigma machine.
+type Enigma struct {
+	rotors    []*Rotor
+	reflector *Reflector
+	plugboard *Plugboard
+}
+
+// NewEnigma creates a new Enigma machine with the given components. Rotors
+// must be ordered fastest-first, i.e. rotors[0] is the rightmost rotor,
+// which steps on every keypress.
+func NewEnigma(rotors []*Rotor, reflector *Reflector, plugboard *Plugboard) *Enigma {
+	return &Enigma{
+		rotors:    rotors,
+		reflector: reflector,
+		plugboard: plugboard,
+	}
+}
+
+// Encrypt normalizes plaintext (uppercasing and dropping anything outside
+// A-Z) and passes it through the machine letter by letter, continuing from
+// the rotors' current positions.
+func (e *Enigma) Encrypt(plaintext string) string {
+	return e.process(plaintext)
+}
+
+// Decrypt passes ciphertext through the machine exactly like Encrypt: the
+// Enigma's substitution is an involution, so feeding the same machine,
+// dialled to the same starting settings, the ciphertext recovers the
+// plaintext.
+func (e *Enigma) Decrypt(ciphertext string) string {
+	return e.process(ciphertext)
+}
+
+// process runs s through the machine one normalized letter at a time.
+func (e *Enigma) process(s string) string {
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for _, c := range s {
+		if c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		if c < 'A' || c > 'Z' {
+			continue
+		}
+		sb.WriteRune(e.encryptRune(c))
+	}
+	return sb.String()
+}
+
+// encryptRune processes a single already-normalized character through the
+// Enigma machine.
+func (e *Enigma) encryptRune(c rune) rune {
+	// Rotors step before the electrical pass, as on the real machine.
+	e.stepRotors()
+
+	// Plugboard swap
+	if swapped, ok := e.plugboard.swaps[c]; ok {
+		c = swapped
+	}
+
+	// Pass through rotors
+	for _, rotor := range e.rotors {
+		c = rotor.process(c)
+	}
+
+	// Pass through reflector
+	c = e.reflector.reflect(c)
+
+	// Pass back through rotors in reverse order
+	for i := len(e.rotors) - 1; i >= 0; i-- {
+		c = e.rotors[i].reverseProcess(c)
+	}
+
+	// Plugboard swap
+	if swapped, ok := e.plugboard.swaps[c]; ok {
+		c = swapped
+	}
+
+	return c
+}
+
+// stepRotors advances the rotors for one keypress, reproducing the
+// historical double-stepping anomaly: the rightmost rotor always steps, and
+// the middle rotor steps both when the right rotor carries it over *and*
+// when it sits on its own notch (in which case it also carries the left
+// rotor over on the same keypress, making it step twice in a row). Any
+// rotors beyond the third (e.g. a non-stepping thin rotor in an M4 setup)
+// are never touched here.
+func (e *Enigma) stepRotors() {
+	if len(e.rotors) == 0 {
+		return
+	}
+
+	rightAtNotch := e.rotors[0].atNotch()
+	middleAtNotch := len(e.rotors) > 1 && e.rotors[1].atNotch()
+
+	e.rotors[0].Step()
+
+	if middleAtNotch {
+		e.rotors[1].Step()
+		if len(e.rotors) > 2 {
+			e.rotors[2].Step()
+		}
+	} else if rightAtNotch && len(e.rotors) > 1 {
+		e.rotors[1].Step()
+	}
+}
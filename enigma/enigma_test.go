@@ -0,0 +1,158 @@
+package enigma
+
+import (
+	"strings"
+	"testing"
+)
+
+// newHistoricalEnigma builds a stock machine with rotors I, II, III
+// (inserted left to right) and reflector B, with no plugboard, matching
+// the setup used by the reference trace below. The rotors slice is kept
+// fastest-first (right to left), so index 0 is rotor III.
+func newHistoricalEnigma(posI, posII, posIII int) *Enigma {
+	rotorI := NewRotor("EKMFLGDQVZNTOWYHXUSPAIBRCJ", 0, posI, 16)
+	rotorII := NewRotor("AJDKSIRUXBLHWTMCQGZNPYFVOE", 0, posII, 4)
+	rotorIII := NewRotor("BDFHJLCPRTXVZNYEIWGAKMUSQO", 0, posIII, 21)
+	reflector := NewReflector("YRUHQSLDPXNGOKMIEBFZCWVJAT")
+	plugboard := NewPlugboard(map[rune]rune{})
+	return NewEnigma([]*Rotor{rotorIII, rotorII, rotorI}, reflector, plugboard)
+}
+
+// TestEncryptKnownReference checks the machine against the widely published
+// reference trace for rotors III, II, I with reflector B, all rings and
+// starting positions at 'A': "AAAAA" encrypts to "BDZGO".
+func TestEncryptKnownReference(t *testing.T) {
+	e := newHistoricalEnigma(0, 0, 0)
+
+	const plaintext = "AAAAA"
+	const want = "BDZGO"
+
+	if got := e.Encrypt(plaintext); got != want {
+		t.Fatalf("Encrypt(%q) = %q, want %q", plaintext, got, want)
+	}
+}
+
+// TestStepRotorsDoubleSteps reproduces the textbook double-stepping trace:
+// starting at window letters A,D,U (left,middle,right) with the right
+// rotor's notch at V and the middle rotor's notch at E, three consecutive
+// keypresses should read A,D,V then A,E,W then B,F,X. The middle rotor
+// moves on both of the last two keypresses in a row: first because the
+// right rotor carries it over, then again because it sits on its own
+// notch and carries the left rotor with it.
+func TestStepRotorsDoubleSteps(t *testing.T) {
+	left := NewRotor("BDFHJLCPRTXVZNYEIWGAKMUSQO", 0, 0, 21)   // A
+	middle := NewRotor("AJDKSIRUXBLHWTMCQGZNPYFVOE", 0, 3, 4)  // D, notch E
+	right := NewRotor("EKMFLGDQVZNTOWYHXUSPAIBRCJ", 0, 20, 21) // U, notch V
+	e := &Enigma{rotors: []*Rotor{right, middle, left}}
+
+	type state struct{ left, middle, right int }
+	want := []state{
+		{0, 3, 21}, // A,D,V
+		{0, 4, 22}, // A,E,W
+		{1, 5, 23}, // B,F,X
+	}
+
+	for i, w := range want {
+		e.stepRotors()
+		got := state{left.position, middle.position, right.position}
+		if got != w {
+			t.Fatalf("after keypress %d: positions = %+v, want %+v", i+1, got, w)
+		}
+	}
+}
+
+// TestNewM3KnownReference checks that NewM3, wired from the catalog, still
+// reproduces the same reference trace as newHistoricalEnigma above.
+func TestNewM3KnownReference(t *testing.T) {
+	e, err := NewM3([3]string{"I", "II", "III"}, "B", [3]int{0, 0, 0}, [3]int{0, 0, 0}, "")
+	if err != nil {
+		t.Fatalf("NewM3: %v", err)
+	}
+
+	const plaintext = "AAAAA"
+	const want = "BDZGO"
+
+	if got := e.Encrypt(plaintext); got != want {
+		t.Fatalf("Encrypt(%q) = %q, want %q", plaintext, got, want)
+	}
+}
+
+// TestEncryptDecryptRoundTrip checks that two machines built with the same
+// settings recover the original plaintext: one encrypts, the other
+// (starting from the same dial settings) decrypts.
+func TestEncryptDecryptRoundTrip(t *testing.T) {
+	const plaintext = "THEQUICKBROWNFOXJUMPSOVERTHELAZYDOG"
+
+	encrypter, err := NewM3([3]string{"I", "II", "III"}, "B", [3]int{1, 2, 3}, [3]int{4, 5, 6}, "AZ BY")
+	if err != nil {
+		t.Fatalf("NewM3: %v", err)
+	}
+	decrypter, err := NewM3([3]string{"I", "II", "III"}, "B", [3]int{1, 2, 3}, [3]int{4, 5, 6}, "AZ BY")
+	if err != nil {
+		t.Fatalf("NewM3: %v", err)
+	}
+
+	ciphertext := encrypter.Encrypt(plaintext)
+	if got := decrypter.Decrypt(ciphertext); got != plaintext {
+		t.Fatalf("Decrypt(Encrypt(%q)) = %q, want %q", plaintext, got, plaintext)
+	}
+}
+
+// TestNewM3NotchCarriesMiddleRotor checks that the catalog's notch
+// positions actually line up with NewRotor's 0-indexed convention: rotor I
+// (rightmost here) starts sitting on its documented turnover (Q, position
+// 16), so the next keypress should carry the middle rotor over.
+func TestNewM3NotchCarriesMiddleRotor(t *testing.T) {
+	e, err := NewM3([3]string{"III", "II", "I"}, "B", [3]int{0, 0, 0}, [3]int{0, 0, 16}, "")
+	if err != nil {
+		t.Fatalf("NewM3: %v", err)
+	}
+
+	e.Encrypt("A")
+
+	right := e.rotors[0]
+	middle := e.rotors[1]
+	if right.position != 17 {
+		t.Fatalf("right rotor position = %d, want 17", right.position)
+	}
+	if middle.position != 1 {
+		t.Fatalf("middle rotor did not get carried over by the right rotor's notch: position = %d, want 1", middle.position)
+	}
+}
+
+func TestNewM3UnknownRotor(t *testing.T) {
+	_, err := NewM3([3]string{"I", "II", "IX"}, "B", [3]int{0, 0, 0}, [3]int{0, 0, 0}, "")
+	if err == nil {
+		t.Fatal("NewM3 with unknown rotor IX: want error, got nil")
+	}
+}
+
+func TestNewM3UnknownReflector(t *testing.T) {
+	_, err := NewM3([3]string{"I", "II", "III"}, "Z", [3]int{0, 0, 0}, [3]int{0, 0, 0}, "")
+	if err == nil {
+		t.Fatal("NewM3 with unknown reflector Z: want error, got nil")
+	}
+}
+
+func TestNewM4RequiresThinLeftmostRotor(t *testing.T) {
+	_, err := NewM4([4]string{"I", "I", "II", "III"}, "BThin", [4]int{0, 0, 0, 0}, [4]int{0, 0, 0, 0}, "")
+	if err == nil {
+		t.Fatal("NewM4 with non-thin leftmost rotor: want error, got nil")
+	}
+}
+
+// TestNewM4ThinRotorDoesNotStep verifies that the M4's leftmost (thin)
+// rotor never advances, even after far more keypresses than it would take
+// to carry a normal third rotor over.
+func TestNewM4ThinRotorDoesNotStep(t *testing.T) {
+	e, err := NewM4([4]string{"Beta", "I", "II", "III"}, "BThin", [4]int{0, 0, 0, 0}, [4]int{0, 0, 0, 0}, "")
+	if err != nil {
+		t.Fatalf("NewM4: %v", err)
+	}
+
+	thin := e.rotors[len(e.rotors)-1]
+	e.Encrypt(strings.Repeat("A", 2000))
+	if thin.position != 0 {
+		t.Fatalf("thin rotor position = %d, want 0 (should never step)", thin.position)
+	}
+}
@@ -0,0 +1,49 @@
+package enigma
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Plugboard represents the plugboard for letter swapping.
+type Plugboard struct {
+	swaps map[rune]rune
+}
+
+// NewPlugboard creates a new plugboard with given swaps.
+func NewPlugboard(swaps map[rune]rune) *Plugboard {
+	return &Plugboard{
+		swaps: swaps,
+	}
+}
+
+// ParsePlugboard parses a plugboard setting given as whitespace-separated
+// two-letter pairs, e.g. "AZ BY CX", into a symmetric swap map. An empty
+// string is a valid, empty plugboard. It rejects pairs that are not
+// exactly two distinct letters, letters used in more than one pair, and a
+// letter mapped to itself.
+func ParsePlugboard(setting string) (map[rune]rune, error) {
+	swaps := make(map[rune]rune)
+	for _, pair := range strings.Fields(setting) {
+		pair = strings.ToUpper(pair)
+		if len(pair) != 2 {
+			return nil, fmt.Errorf("enigma: invalid plugboard pair %q", pair)
+		}
+		a, b := rune(pair[0]), rune(pair[1])
+		if a < 'A' || a > 'Z' || b < 'A' || b > 'Z' {
+			return nil, fmt.Errorf("enigma: invalid plugboard pair %q", pair)
+		}
+		if a == b {
+			return nil, fmt.Errorf("enigma: plugboard letter %q cannot map to itself", a)
+		}
+		if _, dup := swaps[a]; dup {
+			return nil, fmt.Errorf("enigma: plugboard letter %q used more than once", a)
+		}
+		if _, dup := swaps[b]; dup {
+			return nil, fmt.Errorf("enigma: plugboard letter %q used more than once", b)
+		}
+		swaps[a] = b
+		swaps[b] = a
+	}
+	return swaps, nil
+}
@@ -0,0 +1,53 @@
+package enigma
+
+import "testing"
+
+func TestParsePlugboardEmpty(t *testing.T) {
+	swaps, err := ParsePlugboard("")
+	if err != nil {
+		t.Fatalf("ParsePlugboard(\"\"): %v", err)
+	}
+	if len(swaps) != 0 {
+		t.Fatalf("ParsePlugboard(\"\") = %v, want empty map", swaps)
+	}
+}
+
+func TestParsePlugboardPairs(t *testing.T) {
+	swaps, err := ParsePlugboard("AZ BY CX MU LV")
+	if err != nil {
+		t.Fatalf("ParsePlugboard: %v", err)
+	}
+	want := map[rune]rune{
+		'A': 'Z', 'Z': 'A',
+		'B': 'Y', 'Y': 'B',
+		'C': 'X', 'X': 'C',
+		'M': 'U', 'U': 'M',
+		'L': 'V', 'V': 'L',
+	}
+	if len(swaps) != len(want) {
+		t.Fatalf("ParsePlugboard returned %d entries, want %d", len(swaps), len(want))
+	}
+	for k, v := range want {
+		if swaps[k] != v {
+			t.Errorf("swaps[%q] = %q, want %q", k, swaps[k], v)
+		}
+	}
+}
+
+func TestParsePlugboardRejectsDuplicateLetter(t *testing.T) {
+	if _, err := ParsePlugboard("AZ AY"); err == nil {
+		t.Fatal("ParsePlugboard(\"AZ AY\"): want error, got nil")
+	}
+}
+
+func TestParsePlugboardRejectsSelfMap(t *testing.T) {
+	if _, err := ParsePlugboard("AA"); err == nil {
+		t.Fatal("ParsePlugboard(\"AA\"): want error, got nil")
+	}
+}
+
+func TestParsePlugboardRejectsMalformedPair(t *testing.T) {
+	if _, err := ParsePlugboard("ABC"); err == nil {
+		t.Fatal("ParsePlugboard(\"ABC\"): want error, got nil")
+	}
+}
@@ -0,0 +1,18 @@
+package enigma
+
+// Reflector represents the reflector in the Enigma machine.
+type Reflector struct {
+	wiring string // The reflector's wiring
+}
+
+// NewReflector creates a new reflector with given wiring.
+func NewReflector(wiring string) *Reflector {
+	return &Reflector{
+		wiring: wiring,
+	}
+}
+
+// reflect reflects a character through the reflector.
+func (r *Reflector) reflect(c rune) rune {
+	return rune(r.wiring[c-'A'])
+}
@@ -0,0 +1,60 @@
+package enigma
+
+import "strings"
+
+// Rotor represents a single rotor in the Enigma machine.
+type Rotor struct {
+	wiring   string           // The rotor's internal wiring
+	position int              // The current position of the rotor (the visible window letter)
+	ring     int              // Ringstellung: offset between the window letter and the internal wiring
+	notches  map[int]struct{} // Positions at which this rotor causes the next rotor to step
+}
+
+// NewRotor creates a new rotor with a given wiring, ring setting, starting
+// position and one or more notch positions. All values are 0-25, where 0
+// corresponds to 'A'.
+func NewRotor(wiring string, ring, position int, notches ...int) *Rotor {
+	notchSet := make(map[int]struct{}, len(notches))
+	for _, n := range notches {
+		notchSet[n] = struct{}{}
+	}
+	return &Rotor{
+		wiring:   wiring,
+		position: position,
+		ring:     ring,
+		notches:  notchSet,
+	}
+}
+
+// Step advances the rotor position by one letter.
+func (r *Rotor) Step() {
+	r.position = (r.position + 1) % 26
+}
+
+// atNotch reports whether the rotor currently sits on one of its notch
+// positions, i.e. whether stepping this rotor also kicks the next one over.
+func (r *Rotor) atNotch() bool {
+	_, ok := r.notches[r.position]
+	return ok
+}
+
+// process passes a character through the rotor from the entry side. The
+// offset shifts the signal into the rotor's internal wiring frame, and is
+// shifted back out again after the wiring lookup so the next component sees
+// the same alphabet frame it would on the real machine's contact ring.
+func (r *Rotor) process(c rune) rune {
+	offset := ((r.position-r.ring)%26 + 26) % 26
+	in := (int(c-'A') + offset) % 26
+	out := (int(r.wiring[in]-'A') - offset + 26) % 26
+	return rune('A' + out)
+}
+
+// reverseProcess passes a character through the rotor from the reflector
+// side, undoing process.
+func (r *Rotor) reverseProcess(c rune) rune {
+	offset := ((r.position-r.ring)%26 + 26) % 26
+	in := (int(c-'A') + offset) % 26
+	index := strings.IndexByte(r.wiring, byte('A'+in))
+	out := (index - offset + 26) % 26
+	return rune('A' + out)
+}
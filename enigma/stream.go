@@ -0,0 +1,180 @@
+package enigma
+
+import (
+	"bufio"
+	"io"
+)
+
+// streamConfig holds the options for an EncryptWriter.
+type streamConfig struct {
+	preserveNonLetters bool
+	group              int
+	groupSep           string
+	lineWidth          int
+}
+
+// Option configures an EncryptWriter returned by NewEncryptWriter.
+type Option func(*streamConfig)
+
+// WithPreserveNonLetters makes the writer pass non-letter bytes (spaces,
+// punctuation, digits, ...) straight through instead of dropping them. By
+// default they are dropped, matching Encrypt's normalization.
+func WithPreserveNonLetters() Option {
+	return func(c *streamConfig) { c.preserveNonLetters = true }
+}
+
+// WithGrouping makes the writer emit ciphertext in groups of size letters
+// separated by sep, e.g. WithGrouping(5, " ") for the conventional 5-letter
+// blocks. The default, size 0, disables grouping.
+func WithGrouping(size int, sep string) Option {
+	return func(c *streamConfig) { c.group, c.groupSep = size, sep }
+}
+
+// WithLineWidth wraps grouped output onto a new line before a line would
+// exceed width characters. It has no effect unless WithGrouping is also
+// used. The default, 0, disables wrapping.
+func WithLineWidth(width int) Option {
+	return func(c *streamConfig) { c.lineWidth = width }
+}
+
+// encryptWriter is the io.WriteCloser returned by NewEncryptWriter.
+type encryptWriter struct {
+	w       io.Writer
+	e       *Enigma
+	cfg     streamConfig
+	pending []byte
+	lineLen int
+}
+
+// NewEncryptWriter returns an io.WriteCloser that encrypts bytes written to
+// it through e and writes the ciphertext to w, without materializing the
+// whole message in memory. By default the input is normalized (uppercased,
+// non-letters dropped) and the ciphertext is written as-is; use
+// WithPreserveNonLetters, WithGrouping and WithLineWidth to change that.
+// Close must be called to flush any partial group still buffered.
+func NewEncryptWriter(w io.Writer, e *Enigma, opts ...Option) io.WriteCloser {
+	cfg := streamConfig{groupSep: " "}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &encryptWriter{w: w, e: e, cfg: cfg}
+}
+
+// Write implements io.Writer.
+func (ew *encryptWriter) Write(p []byte) (int, error) {
+	for _, b := range p {
+		c := rune(b)
+		if c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		if c < 'A' || c > 'Z' {
+			if ew.cfg.preserveNonLetters {
+				if err := ew.writeRaw(b); err != nil {
+					return 0, err
+				}
+			}
+			continue
+		}
+
+		out := byte(ew.e.encryptRune(c))
+		if ew.cfg.group <= 0 {
+			if err := ew.writeRaw(out); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		ew.pending = append(ew.pending, out)
+		if len(ew.pending) == ew.cfg.group {
+			if err := ew.flushGroup(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(p), nil
+}
+
+// Close flushes any partial group still buffered. It does not close the
+// underlying writer.
+func (ew *encryptWriter) Close() error {
+	if ew.cfg.group > 0 {
+		return ew.flushGroup()
+	}
+	return nil
+}
+
+func (ew *encryptWriter) flushGroup() error {
+	if len(ew.pending) == 0 {
+		return nil
+	}
+	if err := ew.writeGroup(ew.pending); err != nil {
+		return err
+	}
+	ew.pending = ew.pending[:0]
+	return nil
+}
+
+func (ew *encryptWriter) writeGroup(group []byte) error {
+	if ew.lineLen > 0 {
+		if ew.cfg.lineWidth > 0 && ew.lineLen+len(ew.cfg.groupSep)+len(group) > ew.cfg.lineWidth {
+			if _, err := ew.w.Write([]byte("\n")); err != nil {
+				return err
+			}
+			ew.lineLen = 0
+		} else if _, err := ew.w.Write([]byte(ew.cfg.groupSep)); err != nil {
+			return err
+		} else {
+			ew.lineLen += len(ew.cfg.groupSep)
+		}
+	}
+	if _, err := ew.w.Write(group); err != nil {
+		return err
+	}
+	ew.lineLen += len(group)
+	return nil
+}
+
+func (ew *encryptWriter) writeRaw(b byte) error {
+	_, err := ew.w.Write([]byte{b})
+	return err
+}
+
+// decryptReader is the io.Reader returned by NewDecryptReader.
+type decryptReader struct {
+	br *bufio.Reader
+	e  *Enigma
+}
+
+// NewDecryptReader returns an io.Reader that reads ciphertext from r,
+// normalizes it (uppercasing, dropping non-letters such as the spaces and
+// newlines used to group ciphertext), and decrypts it through e one letter
+// at a time without materializing the whole message in memory.
+func NewDecryptReader(r io.Reader, e *Enigma) io.Reader {
+	return &decryptReader{br: bufio.NewReader(r), e: e}
+}
+
+// Read implements io.Reader.
+func (dr *decryptReader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		b, err := dr.br.ReadByte()
+		if err != nil {
+			if n > 0 {
+				return n, nil
+			}
+			return 0, err
+		}
+
+		c := rune(b)
+		if c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		if c < 'A' || c > 'Z' {
+			continue
+		}
+
+		p[n] = byte(dr.e.encryptRune(c))
+		n++
+	}
+	return n, nil
+}
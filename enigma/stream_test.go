@@ -0,0 +1,204 @@
+package enigma
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func newTestM3(t testing.TB) *Enigma {
+	t.Helper()
+	e, err := NewM3([3]string{"I", "II", "III"}, "B", [3]int{0, 0, 0}, [3]int{0, 0, 0}, "")
+	if err != nil {
+		t.Fatalf("NewM3: %v", err)
+	}
+	return e
+}
+
+func TestEncryptWriterMatchesEncrypt(t *testing.T) {
+	const plaintext = "The quick brown fox, jumps over 123 the lazy dog!"
+
+	var buf bytes.Buffer
+	w := NewEncryptWriter(&buf, newTestM3(t))
+	if _, err := io.WriteString(w, plaintext); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	want := newTestM3(t).Encrypt(plaintext)
+	if buf.String() != want {
+		t.Fatalf("EncryptWriter output = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestEncryptWriterGroupingAndLineWidth(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewEncryptWriter(&buf, newTestM3(t), WithGrouping(5, " "), WithLineWidth(11))
+	if _, err := io.WriteString(w, strings.Repeat("A", 15)); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	lines := strings.Split(buf.String(), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2: %q", len(lines), buf.String())
+	}
+	for _, line := range lines {
+		if len(line) > 11 {
+			t.Errorf("line %q exceeds width 11", line)
+		}
+	}
+}
+
+func TestEncryptWriterFlushesPartialGroupOnClose(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewEncryptWriter(&buf, newTestM3(t), WithGrouping(5, " "))
+	if _, err := io.WriteString(w, "AB"); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Fatalf("buffer should be empty before Close, got %q", buf.String())
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if buf.Len() != 2 {
+		t.Fatalf("Close did not flush partial group, got %q", buf.String())
+	}
+}
+
+func TestEncryptWriterPreserveNonLetters(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewEncryptWriter(&buf, newTestM3(t), WithPreserveNonLetters())
+	if _, err := io.WriteString(w, "HI, BOB!"); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	got := buf.String()
+	if !strings.Contains(got, ", ") || !strings.Contains(got, "!") {
+		t.Fatalf("EncryptWriter with WithPreserveNonLetters dropped punctuation: %q", got)
+	}
+}
+
+func TestDecryptReaderRoundTrip(t *testing.T) {
+	const plaintext = "ATTACKATDAWNTHEGENERALHASARRIVED"
+
+	var ciphertext bytes.Buffer
+	w := NewEncryptWriter(&ciphertext, newTestM3(t))
+	if _, err := io.WriteString(w, plaintext); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	r := NewDecryptReader(&ciphertext, newTestM3(t))
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if string(got) != plaintext {
+		t.Fatalf("round trip = %q, want %q", got, plaintext)
+	}
+}
+
+// filterUpper reproduces the normalization every cipher in this module
+// applies: uppercase, drop anything outside A-Z.
+func filterUpper(data []byte) string {
+	var sb strings.Builder
+	for _, b := range data {
+		c := rune(b)
+		if c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		if c >= 'A' && c <= 'Z' {
+			sb.WriteRune(c)
+		}
+	}
+	return sb.String()
+}
+
+// FuzzEncryptDecryptRoundTrip checks that arbitrary byte streams, pushed
+// through an EncryptWriter and then a DecryptReader built from Enigma
+// machines with identical starting settings, come back out as exactly
+// their filtered (uppercased, letters-only) plaintext.
+func FuzzEncryptDecryptRoundTrip(f *testing.F) {
+	f.Add([]byte("Hello, World! 123"))
+	f.Add([]byte(""))
+	f.Add([]byte("AAAAAAAAAA"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var ciphertext bytes.Buffer
+		w := NewEncryptWriter(&ciphertext, newTestM3(t))
+		if _, err := w.Write(data); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("Close: %v", err)
+		}
+
+		r := NewDecryptReader(&ciphertext, newTestM3(t))
+		got, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatalf("ReadAll: %v", err)
+		}
+
+		if want := filterUpper(data); string(got) != want {
+			t.Fatalf("round trip mismatch: got %q, want %q", got, want)
+		}
+	})
+}
+
+// BenchmarkEncryptWriterThroughput measures EncryptWriter's throughput on
+// a 10 MB buffer.
+func BenchmarkEncryptWriterThroughput(b *testing.B) {
+	data := make([]byte, 10*1024*1024)
+	rnd := rand.New(rand.NewSource(1))
+	for i := range data {
+		data[i] = byte('A' + rnd.Intn(26))
+	}
+
+	e := newTestM3(b)
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		w := NewEncryptWriter(io.Discard, e)
+		if _, err := w.Write(data); err != nil {
+			b.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkDecryptReaderThroughput measures NewDecryptReader's throughput
+// reading a 10 MB ciphertext buffer.
+func BenchmarkDecryptReaderThroughput(b *testing.B) {
+	data := make([]byte, 10*1024*1024)
+	rnd := rand.New(rand.NewSource(1))
+	for i := range data {
+		data[i] = byte('A' + rnd.Intn(26))
+	}
+	ciphertext := newTestM3(b).Encrypt(string(data))
+
+	e := newTestM3(b)
+	b.SetBytes(int64(len(ciphertext)))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		r := NewDecryptReader(strings.NewReader(ciphertext), e)
+		if _, err := io.Copy(io.Discard, r); err != nil {
+			b.Fatal(err)
+		}
+	}
+}